@@ -0,0 +1,198 @@
+// Copyright 2018 visualfc. All rights reserved.
+
+package tk
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"unicode"
+)
+
+// Accelerator is a structured representation of a keyboard shortcut,
+// parsed from a human-readable string such as "Ctrl+Shift+S" into the
+// modifier bitmask plus the Tk keysym it triggers on.
+type Accelerator struct {
+	Mods KeyModifier
+	Key  string
+}
+
+var namedKeys = map[string]string{
+	"UP":        "Up",
+	"DOWN":      "Down",
+	"LEFT":      "Left",
+	"RIGHT":     "Right",
+	"HOME":      "Home",
+	"END":       "End",
+	"PAGEUP":    "Prior",
+	"PAGEDOWN":  "Next",
+	"SPACE":     "space",
+	"TAB":       "Tab",
+	"RETURN":    "Return",
+	"ENTER":     "Return",
+	"ESCAPE":    "Escape",
+	"ESC":       "Escape",
+	"BACKSPACE": "BackSpace",
+	"DELETE":    "Delete",
+	"INSERT":    "Insert",
+}
+
+func isFunctionKey(token string) (string, bool) {
+	if len(token) < 2 || (token[0] != 'F' && token[0] != 'f') {
+		return "", false
+	}
+	for _, r := range token[1:] {
+		if r < '0' || r > '9' {
+			return "", false
+		}
+	}
+	var n int
+	fmt.Sscanf(token[1:], "%d", &n)
+	if n < 1 || n > 24 {
+		return "", false
+	}
+	return fmt.Sprintf("F%d", n), true
+}
+
+// ParseAccelerator converts a human-readable shortcut string such as
+// "Ctrl+Shift+S", "Alt+F4" or "Cmd+Z" into an Accelerator. The "Cmd"
+// token resolves to Command on darwin and Control on every other
+// platform.
+func ParseAccelerator(accel string) (Accelerator, error) {
+	var a Accelerator
+	parts := strings.FieldsFunc(accel, func(r rune) bool {
+		return r == '+' || r == '-'
+	})
+	if len(parts) == 0 {
+		return a, ErrInvalid
+	}
+	for i, part := range parts {
+		last := i == len(parts)-1
+		token := strings.ToUpper(part)
+		switch token {
+		case "CTRL", "CONTROL":
+			a.Mods |= KeyModifierControl
+			continue
+		case "SHIFT":
+			a.Mods |= KeyModifierShift
+			continue
+		case "ALT":
+			a.Mods |= KeyModifierAlt
+			continue
+		case "META":
+			a.Mods |= KeyModifierMeta
+			continue
+		case "SUPER":
+			a.Mods |= KeyModifierFn
+			continue
+		case "CMD":
+			if runtime.GOOS == "darwin" {
+				a.Mods |= KeyModifierMeta
+			} else {
+				a.Mods |= KeyModifierControl
+			}
+			continue
+		}
+		if !last {
+			return Accelerator{}, fmt.Errorf("tk: invalid accelerator modifier %q", part)
+		}
+		if key, ok := namedKeys[token]; ok {
+			a.Key = key
+			continue
+		}
+		if key, ok := isFunctionKey(part); ok {
+			a.Key = key
+			continue
+		}
+		r := []rune(part)
+		if len(r) != 1 {
+			return Accelerator{}, fmt.Errorf("tk: invalid accelerator key %q", part)
+		}
+		a.Key = string(unicode.ToLower(r[0]))
+	}
+	if a.Key == "" {
+		return Accelerator{}, fmt.Errorf("tk: accelerator %q has no key", accel)
+	}
+	return a, nil
+}
+
+// TkSequence renders the accelerator as a Tk event sequence, e.g.
+// "<Control-Shift-KeyPress-S>" for "Ctrl+Shift+S".
+func (a Accelerator) TkSequence() string {
+	var mods []string
+	if a.Mods&KeyModifierControl == KeyModifierControl {
+		mods = append(mods, "Control")
+	}
+	if a.Mods&KeyModifierShift == KeyModifierShift {
+		mods = append(mods, "Shift")
+	}
+	if a.Mods&KeyModifierAlt == KeyModifierAlt {
+		mods = append(mods, "Alt")
+	}
+	if a.Mods&KeyModifierMeta == KeyModifierMeta {
+		if runtime.GOOS == "darwin" {
+			mods = append(mods, "Command")
+		} else {
+			mods = append(mods, "Meta")
+		}
+	}
+	if a.Mods&KeyModifierFn == KeyModifierFn {
+		mods = append(mods, "Super")
+	}
+	key := a.Key
+	if a.Mods&KeyModifierShift == KeyModifierShift {
+		if r := []rune(key); len(r) == 1 {
+			// X11/Tk deliver the shifted keysym (e.g. "S", not "s")
+			// when Shift is held down with a single printable key.
+			key = string(unicode.ToUpper(r[0]))
+		}
+	}
+	parts := append(mods, "KeyPress-"+key)
+	return "<" + strings.Join(parts, "-") + ">"
+}
+
+// String renders the accelerator back into human-readable form, e.g.
+// "Ctrl+Shift+S".
+func (a Accelerator) String() string {
+	var parts []string
+	if a.Mods&KeyModifierControl == KeyModifierControl {
+		parts = append(parts, "Ctrl")
+	}
+	if a.Mods&KeyModifierShift == KeyModifierShift {
+		parts = append(parts, "Shift")
+	}
+	if a.Mods&KeyModifierAlt == KeyModifierAlt {
+		parts = append(parts, "Alt")
+	}
+	if a.Mods&KeyModifierMeta == KeyModifierMeta {
+		parts = append(parts, "Meta")
+	}
+	if a.Mods&KeyModifierFn == KeyModifierFn {
+		parts = append(parts, "Super")
+	}
+	key := a.Key
+	if r := []rune(key); len(r) == 1 {
+		key = strings.ToUpper(key)
+	}
+	parts = append(parts, key)
+	return strings.Join(parts, "+")
+}
+
+// BindAccelerator parses accel and binds it on widget, invoking fn
+// whenever the resulting key sequence fires. This lets callers attach
+// menu-style shortcuts declaratively instead of hand-writing Tk key
+// sequences such as "<Control-KeyPress-s>".
+func BindAccelerator(widget Widget, accel string, fn func(*KeyEvent)) error {
+	if !IsValidWidget(widget) {
+		return ErrInvalid
+	}
+	a, err := ParseAccelerator(accel)
+	if err != nil {
+		return err
+	}
+	return BindEvent(widget.Id(), a.TkSequence(), func(e *Event) {
+		if fn != nil {
+			fn(&KeyEvent{Event: e, KeyModifier: a.Mods})
+		}
+	})
+}
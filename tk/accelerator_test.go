@@ -0,0 +1,39 @@
+// Copyright 2018 visualfc. All rights reserved.
+
+package tk
+
+import "testing"
+
+func TestParseAccelerator(t *testing.T) {
+	cases := []struct {
+		accel string
+		seq   string
+	}{
+		// Shift held with a single letter delivers the shifted (capital)
+		// keysym in real X11/Tk, not the lowercase one.
+		{"Ctrl+Shift+S", "<Control-Shift-KeyPress-S>"},
+		{"Alt+F4", "<Alt-KeyPress-F4>"},
+		{"Ctrl+Z", "<Control-KeyPress-z>"},
+		{"Ctrl+Shift+Z", "<Control-Shift-KeyPress-Z>"},
+		{"Ctrl+Home", "<Control-KeyPress-Home>"},
+		{"Ctrl+PageDown", "<Control-KeyPress-Next>"},
+		{"Ctrl+Shift+Home", "<Control-Shift-KeyPress-Home>"},
+	}
+	for _, c := range cases {
+		a, err := ParseAccelerator(c.accel)
+		if err != nil {
+			t.Fatal(c.accel, err)
+		}
+		if v := a.TkSequence(); v != c.seq {
+			t.Fatal(c.accel, c.seq, v)
+		}
+	}
+}
+
+func TestParseAcceleratorInvalid(t *testing.T) {
+	for _, accel := range []string{"", "Ctrl+", "Ctrl+Shift"} {
+		if _, err := ParseAccelerator(accel); err == nil {
+			t.Fatal(accel, "expect error")
+		}
+	}
+}
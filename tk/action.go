@@ -0,0 +1,155 @@
+// Copyright 2018 visualfc. All rights reserved.
+
+package tk
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Action is a named, app-wide command backed by a Tk virtual event
+// (<<Name>>). Menus, buttons, and toolbars bind to the action instead
+// of repeating accelerator strings, and can be disabled or relabeled
+// in one place.
+type Action struct {
+	name         string
+	virtual      string
+	label        string
+	enabled      bool
+	accelerators []string
+}
+
+var (
+	actionsMu sync.Mutex
+	actions   []*Action
+)
+
+// NewAction creates an Action wrapping the virtual event <<name>>.
+func NewAction(name string) *Action {
+	a := &Action{
+		name:    name,
+		virtual: fmt.Sprintf("<<%v>>", name),
+		enabled: true,
+	}
+	actionsMu.Lock()
+	actions = append(actions, a)
+	actionsMu.Unlock()
+	return a
+}
+
+// RegisteredActions returns every Action created with NewAction that
+// has not yet been destroyed.
+func RegisteredActions() []*Action {
+	actionsMu.Lock()
+	defer actionsMu.Unlock()
+	r := make([]*Action, len(actions))
+	copy(r, actions)
+	return r
+}
+
+// Name returns the action's name, as passed to NewAction.
+func (a *Action) Name() string {
+	return a.name
+}
+
+// VirtualEvent returns the underlying <<Name>> virtual event.
+func (a *Action) VirtualEvent() string {
+	return a.virtual
+}
+
+// SetAccelerators parses each accel with ParseAccelerator and registers
+// the resulting key sequences on the action's virtual event via
+// "event add". Any accelerators previously set on the action are
+// removed once the new ones are known to parse cleanly, so a bad
+// accel in the new list leaves the action's existing shortcuts intact
+// instead of dropping them.
+func (a *Action) SetAccelerators(accels ...string) error {
+	var sequences []string
+	for _, accel := range accels {
+		parsed, err := ParseAccelerator(accel)
+		if err != nil {
+			return err
+		}
+		sequences = append(sequences, parsed.TkSequence())
+	}
+	if len(a.accelerators) > 0 {
+		RemoveVirtualEventPhysicalEvent(a.virtual, a.accelerators...)
+		a.accelerators = nil
+	}
+	if len(sequences) == 0 {
+		return nil
+	}
+	if err := AddVirtualEventPhysicalEvent(a.virtual, sequences[0], sequences[1:]...); err != nil {
+		return err
+	}
+	a.accelerators = sequences
+	return nil
+}
+
+// Accelerators returns the Tk key sequences currently registered for
+// the action.
+func (a *Action) Accelerators() []string {
+	return a.accelerators
+}
+
+// Enabled reports whether the action is currently enabled.
+func (a *Action) Enabled() bool {
+	return a.enabled
+}
+
+// SetEnabled enables or disables the action. Widgets bound to the
+// action are expected to consult Enabled when deciding whether to
+// react to Trigger.
+func (a *Action) SetEnabled(enabled bool) *Action {
+	a.enabled = enabled
+	return a
+}
+
+// Label returns the action's display label.
+func (a *Action) Label() string {
+	return a.label
+}
+
+// SetLabel sets the action's display label, used by menus and buttons
+// bound to the action.
+func (a *Action) SetLabel(label string) *Action {
+	a.label = label
+	return a
+}
+
+// Trigger fires the action's virtual event on the focus widget.
+func (a *Action) Trigger() error {
+	return SendEventToFocus(a.virtual)
+}
+
+// OnTriggered binds fn to the action's virtual event across every
+// widget (bind tag "all"), invoked whenever the action fires (via
+// Trigger, a bound accelerator, or a direct "event generate").
+func (a *Action) OnTriggered(fn func()) error {
+	return BindEvent("all", a.virtual, func(e *Event) {
+		if a.enabled && fn != nil {
+			fn()
+		}
+	})
+}
+
+// Destroy unregisters the action's accelerators, clears any
+// OnTriggered binding, and removes it from RegisteredActions. Since
+// BindEvent binds additively (with "+"), skipping this would leave a
+// stale closure bound to tag "all" firing alongside a later action
+// created with the same name.
+func (a *Action) Destroy() {
+	if len(a.accelerators) > 0 {
+		RemoveVirtualEventPhysicalEvent(a.virtual, a.accelerators...)
+		a.accelerators = nil
+	}
+	ClearBindEvent("all", a.virtual)
+	actionsMu.Lock()
+	for i, v := range actions {
+		if v == a {
+			actions = append(actions[:i], actions[i+1:]...)
+			break
+		}
+	}
+	actionsMu.Unlock()
+}
@@ -0,0 +1,100 @@
+// Copyright 2018 visualfc. All rights reserved.
+
+package tk
+
+import "testing"
+
+// TestActionLifecycle exercises the pure bookkeeping on Action —
+// naming, label/enabled state, and registry membership. No
+// accelerators are set, so no "event add" calls are made; Destroy
+// still evals the "bind" command that clears the OnTriggered binding.
+func TestActionLifecycle(t *testing.T) {
+	before := len(RegisteredActions())
+
+	a := NewAction("Save")
+	defer a.Destroy()
+
+	if a.Name() != "Save" {
+		t.Fatalf("Name() = %q, want %q", a.Name(), "Save")
+	}
+	if a.VirtualEvent() != "<<Save>>" {
+		t.Fatalf("VirtualEvent() = %q, want %q", a.VirtualEvent(), "<<Save>>")
+	}
+	if !a.Enabled() {
+		t.Fatal("new action should be enabled by default")
+	}
+	a.SetEnabled(false)
+	if a.Enabled() {
+		t.Fatal("SetEnabled(false) did not disable the action")
+	}
+	a.SetLabel("Save File")
+	if a.Label() != "Save File" {
+		t.Fatalf("Label() = %q, want %q", a.Label(), "Save File")
+	}
+
+	found := false
+	for _, r := range RegisteredActions() {
+		if r == a {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatal("NewAction did not register the action in RegisteredActions")
+	}
+	if len(RegisteredActions()) != before+1 {
+		t.Fatalf("RegisteredActions() len = %v, want %v", len(RegisteredActions()), before+1)
+	}
+
+	a.Destroy()
+	for _, r := range RegisteredActions() {
+		if r == a {
+			t.Fatal("Destroy did not remove the action from RegisteredActions")
+		}
+	}
+}
+
+func init() {
+	registerTest("ActionAccelerators", testActionAccelerators)
+}
+
+// testActionAccelerators exercises the Tk-backed paths on Action: a
+// failed SetAccelerators call must not clobber the previously-working
+// accelerators, and Destroy must clear the OnTriggered binding so a
+// later action reusing the same name doesn't also fire the old one.
+func testActionAccelerators(t *testing.T) {
+	a := NewAction("TestActionAccel")
+	defer a.Destroy()
+
+	if err := a.SetAccelerators("Ctrl+S"); err != nil {
+		t.Fatal(err)
+	}
+	if got := a.Accelerators(); len(got) != 1 || got[0] != "<Control-KeyPress-s>" {
+		t.Fatal("Accelerators", got)
+	}
+
+	if err := a.SetAccelerators("Ctrl+S", "not a valid accel"); err == nil {
+		t.Fatal("expected SetAccelerators to fail on an invalid accelerator")
+	}
+	if got := a.Accelerators(); len(got) != 1 || got[0] != "<Control-KeyPress-s>" {
+		t.Fatal("a failed SetAccelerators call dropped the existing accelerators", got)
+	}
+
+	triggered := make(chan struct{}, 1)
+	if err := a.OnTriggered(func() { triggered <- struct{}{} }); err != nil {
+		t.Fatal(err)
+	}
+	virtual := a.VirtualEvent()
+	a.Destroy()
+
+	b := NewAction("TestActionAccel")
+	defer b.Destroy()
+	if b.VirtualEvent() != virtual {
+		t.Fatal("expected the new action to reuse the same virtual event name")
+	}
+	b.Trigger()
+	select {
+	case <-triggered:
+		t.Fatal("destroyed action's OnTriggered closure fired after Destroy")
+	default:
+	}
+}
@@ -84,6 +84,14 @@ type Event struct {
 	//a decimal string is substituted. For Visibility, one of the strings VisibilityUnobscured, VisibilityPartiallyObscured, and VisibilityFullyObscured is substituted.
 	//For Property events, substituted with either the string NewValue (indicating that the property has been created or modified) or Delete (indicating that the property has been removed).
 	State string
+
+	//ModifierState and ButtonState decode State into structured bitmasks.
+	//Valid for ButtonPress, ButtonRelease, Motion, KeyPress, KeyRelease, Enter, and Leave events.
+	//Tk reports the true modifier/button state at the time of the event, so
+	//e.g. e.ModifierState&KeyModifierControl works even when the modifier
+	//key was pressed outside the window or focus was lost in between.
+	ModifierState KeyModifier
+	ButtonState   ButtonState
 }
 
 func (e *Event) params() string {
@@ -116,6 +124,7 @@ func (e *Event) parser(args []string) {
 	e.OverrideRedirect = e.toString(args[16])
 	e.Place = e.toString(args[17])
 	e.State = e.toString(args[18])
+	e.ModifierState, e.ButtonState = decodeState(e.State)
 	e.GlobalPosX = e.toInt(args[19])
 	e.GlobalPosY = e.toInt(args[20])
 }
@@ -172,6 +181,65 @@ func (k KeyModifier) String() string {
 	return strings.Join(ar, " ")
 }
 
+//ButtonState is a bitmask of the mouse buttons held down at the time of
+//an event, decoded from the %s state field (Button1=0x100 .. Button5=0x1000).
+type ButtonState int
+
+const (
+	ButtonState1 ButtonState = 1 << (8 + iota)
+	ButtonState2
+	ButtonState3
+	ButtonState4
+	ButtonState5
+)
+
+//decodeState decodes the raw %s state bitmask Tk reports for ButtonPress,
+//ButtonRelease, Motion, KeyPress, KeyRelease, Enter, and Leave events
+//(Shift=1, Lock=2, Control=4, Mod1=8, Mod3=32 (Meta), Mod4=64 (Super),
+//Button1=256, ...) into the structured KeyModifier/ButtonState bitmasks.
+//Mod3 is reserved here for Meta/Command so it round-trips with
+//EventAttrState. Unrecognized or non-numeric state strings (e.g. the
+//Visibility/Property string states) decode to zero.
+func decodeState(state string) (KeyModifier, ButtonState) {
+	v, err := strconv.ParseInt(state, 10, 0)
+	if err != nil {
+		return 0, 0
+	}
+	var mods KeyModifier
+	if v&0x1 != 0 {
+		mods |= KeyModifierShift
+	}
+	if v&0x4 != 0 {
+		mods |= KeyModifierControl
+	}
+	if v&0x8 != 0 {
+		mods |= KeyModifierAlt
+	}
+	if v&0x20 != 0 {
+		mods |= KeyModifierMeta
+	}
+	if v&0x40 != 0 {
+		mods |= KeyModifierFn
+	}
+	var buttons ButtonState
+	if v&0x100 != 0 {
+		buttons |= ButtonState1
+	}
+	if v&0x200 != 0 {
+		buttons |= ButtonState2
+	}
+	if v&0x400 != 0 {
+		buttons |= ButtonState3
+	}
+	if v&0x800 != 0 {
+		buttons |= ButtonState4
+	}
+	if v&0x1000 != 0 {
+		buttons |= ButtonState5
+	}
+	return mods, buttons
+}
+
 type KeyEvent struct {
 	*Event
 	KeyModifier KeyModifier
@@ -0,0 +1,95 @@
+// Copyright 2018 visualfc. All rights reserved.
+
+package tk
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// EventAttrKeySym sets the -keysym attribute of a generated event.
+func EventAttrKeySym(keysym string) *EventAttr {
+	return NativeEventAttr("keysym", keysym)
+}
+
+// EventAttrKeyCode sets the -keycode attribute of a generated event.
+func EventAttrKeyCode(keycode int) *EventAttr {
+	return NativeEventAttr("keycode", strconv.Itoa(keycode))
+}
+
+// EventAttrState sets the -state attribute of a generated event to the
+// numeric bitmask Tk expects, built from mods the same way %s is
+// decoded by decodeState (including Meta, so a parsed "Cmd+Z"
+// accelerator round-trips through SendKey without losing its modifier).
+func EventAttrState(mods KeyModifier) *EventAttr {
+	var v int
+	if mods&KeyModifierShift == KeyModifierShift {
+		v |= 0x1
+	}
+	if mods&KeyModifierControl == KeyModifierControl {
+		v |= 0x4
+	}
+	if mods&KeyModifierAlt == KeyModifierAlt {
+		v |= 0x8
+	}
+	if mods&KeyModifierMeta == KeyModifierMeta {
+		v |= 0x20
+	}
+	if mods&KeyModifierFn == KeyModifierFn {
+		v |= 0x40
+	}
+	return NativeEventAttr("state", strconv.Itoa(v))
+}
+
+// EventAttrX sets the -x attribute of a generated event.
+func EventAttrX(x int) *EventAttr {
+	return NativeEventAttr("x", strconv.Itoa(x))
+}
+
+// EventAttrY sets the -y attribute of a generated event.
+func EventAttrY(y int) *EventAttr {
+	return NativeEventAttr("y", strconv.Itoa(y))
+}
+
+// EventAttrButton sets the -button attribute of a generated event.
+func EventAttrButton(button int) *EventAttr {
+	return NativeEventAttr("button", strconv.Itoa(button))
+}
+
+// EventAttrDelta sets the -delta attribute of a generated MouseWheel
+// event.
+func EventAttrDelta(delta int) *EventAttr {
+	return NativeEventAttr("delta", strconv.Itoa(delta))
+}
+
+// EventAttrWhen sets the -when attribute of a generated event, one of
+// "now", "tail", "head", or "mark".
+func EventAttrWhen(when string) *EventAttr {
+	return NativeEventAttr("when", when)
+}
+
+// SendKey generates a <KeyPress> event for keysym on widget, with the
+// given modifiers encoded into -state.
+func SendKey(w Widget, keysym string, mods KeyModifier) error {
+	return SendEvent(w, "<KeyPress>", EventAttrKeySym(keysym), EventAttrState(mods))
+}
+
+// SendClick generates a <ButtonPress>/<ButtonRelease> pair for button
+// at x, y on widget.
+func SendClick(w Widget, button int, x int, y int) error {
+	attrs := []*EventAttr{EventAttrButton(button), EventAttrX(x), EventAttrY(y)}
+	if err := SendEvent(w, "<ButtonPress>", attrs...); err != nil {
+		return err
+	}
+	return SendEvent(w, "<ButtonRelease>", attrs...)
+}
+
+// SendVirtual generates the virtual event name (wrapped in << >> if
+// not already) on widget, passing data as its -data attribute.
+func SendVirtual(w Widget, name string, data string) error {
+	virtual := name
+	if !IsVirtualEvent(virtual) {
+		virtual = fmt.Sprintf("<<%v>>", name)
+	}
+	return SendEvent(w, virtual, NativeEventAttr("data", data))
+}
@@ -0,0 +1,40 @@
+// Copyright 2018 visualfc. All rights reserved.
+
+package tk
+
+import "testing"
+
+func TestEventAttrState(t *testing.T) {
+	cases := []struct {
+		mods  KeyModifier
+		value string
+	}{
+		{0, "0"},
+		{KeyModifierShift, "1"},
+		{KeyModifierControl, "4"},
+		{KeyModifierAlt, "8"},
+		{KeyModifierMeta, "32"},
+		{KeyModifierFn, "64"},
+		{KeyModifierShift | KeyModifierControl | KeyModifierMeta, "37"},
+	}
+	for _, c := range cases {
+		attr := EventAttrState(c.mods)
+		if attr.key != "state" || attr.value != c.value {
+			t.Fatalf("EventAttrState(%v) = -%v {%v}, want -state {%v}", c.mods, attr.key, attr.value, c.value)
+		}
+	}
+}
+
+// TestEventAttrStateRoundTrip guards against EventAttrState and
+// decodeState drifting apart: every modifier EventAttrState encodes
+// must decode back to the same KeyModifier bits.
+func TestEventAttrStateRoundTrip(t *testing.T) {
+	all := KeyModifierShift | KeyModifierControl | KeyModifierAlt | KeyModifierMeta | KeyModifierFn
+	for mods := KeyModifier(0); mods <= all; mods++ {
+		attr := EventAttrState(mods & all)
+		decoded, _ := decodeState(attr.value)
+		if decoded != mods&all {
+			t.Fatalf("round trip of %v: encoded %v, decoded %v", mods&all, attr.value, decoded)
+		}
+	}
+}
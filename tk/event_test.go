@@ -0,0 +1,27 @@
+// Copyright 2018 visualfc. All rights reserved.
+
+package tk
+
+import "testing"
+
+func TestDecodeState(t *testing.T) {
+	cases := []struct {
+		state string
+		mods  KeyModifier
+		btns  ButtonState
+	}{
+		{"0", 0, 0},
+		{"4", KeyModifierControl, 0},
+		{"5", KeyModifierShift | KeyModifierControl, 0},
+		{"256", 0, ButtonState1},
+		{"13", KeyModifierShift | KeyModifierControl | KeyModifierAlt, 0},
+		{"32", KeyModifierMeta, 0},
+		{"VisibilityUnobscured", 0, 0},
+	}
+	for _, c := range cases {
+		mods, btns := decodeState(c.state)
+		if mods != c.mods || btns != c.btns {
+			t.Fatalf("decodeState(%q) = %v,%v want %v,%v", c.state, mods, btns, c.mods, c.btns)
+		}
+	}
+}
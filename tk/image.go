@@ -15,8 +15,17 @@ import (
 )
 
 type Image struct {
-	id    string
-	photo *interp.Photo
+	id       string
+	photo    *interp.Photo
+	variants map[float64]image.Image
+
+	frames     []image.Image
+	delays     []int
+	frameIndex int
+	playing    bool
+	loop       bool
+	onFrame    func(int)
+	animFnId   string
 }
 
 func (i *Image) Id() string {
@@ -62,6 +71,13 @@ func LoadImage(file string, options ...*ImageOpt) (*Image, error) {
 	if fileImage != nil {
 		im.SetImage(fileImage)
 	}
+	if filepath.Ext(file) == ".gif" {
+		if frames, delays, err := decodeGifFrames(file); err == nil && len(frames) > 1 {
+			im.frames = frames
+			im.delays = delays
+			im.loop = true
+		}
+	}
 	return im, nil
 }
 
@@ -95,7 +111,7 @@ func NewImage(options ...*ImageOpt) *Image {
 	if photo == nil {
 		return nil
 	}
-	return &Image{iid, photo}
+	return &Image{id: iid, photo: photo}
 }
 
 func (i *Image) IsValid() bool {
@@ -166,5 +182,5 @@ func parserImageResult(id string, err error) *Image {
 	if photo == nil {
 		return nil
 	}
-	return &Image{id, photo}
+	return &Image{id: id, photo: photo}
 }
@@ -0,0 +1,170 @@
+// Copyright 2018 visualfc. All rights reserved.
+
+package tk
+
+import (
+	"fmt"
+	"image"
+	"image/draw"
+	"image/gif"
+	"os"
+	"sync/atomic"
+)
+
+var animSeq int64
+
+func makeAnimId() string {
+	return fmt.Sprintf("tk_anim_id_%v", atomic.AddInt64(&animSeq, 1))
+}
+
+// decodeGifFrames decodes every frame of an animated GIF along with
+// its per-frame delay, converted from the GIF's 1/100s units to
+// milliseconds.
+func decodeGifFrames(file string) ([]image.Image, []int, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+	g, err := gif.DecodeAll(f)
+	if err != nil {
+		return nil, nil, err
+	}
+	frames := compositeGifFrames(g)
+	delays := make([]int, len(g.Delay))
+	for idx, d := range g.Delay {
+		ms := d * 10
+		if ms <= 0 {
+			ms = 100
+		}
+		delays[idx] = ms
+	}
+	return frames, delays, nil
+}
+
+// compositeGifFrames draws each decoded GIF frame onto a persistent
+// full-canvas image, honoring g.Disposal. image/gif.DecodeAll hands
+// back each frame as only the sub-rectangle its GIF block redraws
+// (see image/gif's newImageFromDescriptor), so most real animated
+// GIFs -- which only redraw the changed region per frame -- would
+// otherwise show a cropped frame instead of the full picture.
+func compositeGifFrames(g *gif.GIF) []image.Image {
+	bounds := image.Rect(0, 0, g.Config.Width, g.Config.Height)
+	canvas := image.NewRGBA(bounds)
+	frames := make([]image.Image, len(g.Image))
+	for idx, src := range g.Image {
+		var disposal byte
+		if idx < len(g.Disposal) {
+			disposal = g.Disposal[idx]
+		}
+		var previous *image.RGBA
+		if disposal == gif.DisposalPrevious {
+			previous = image.NewRGBA(bounds)
+			draw.Draw(previous, bounds, canvas, image.Point{}, draw.Src)
+		}
+		draw.Draw(canvas, src.Bounds(), src, src.Bounds().Min, draw.Over)
+		frame := image.NewRGBA(bounds)
+		draw.Draw(frame, bounds, canvas, image.Point{}, draw.Src)
+		frames[idx] = frame
+		switch disposal {
+		case gif.DisposalBackground:
+			draw.Draw(canvas, src.Bounds(), image.Transparent, image.Point{}, draw.Src)
+		case gif.DisposalPrevious:
+			canvas = previous
+		}
+	}
+	return frames
+}
+
+// FrameCount returns the number of decoded animation frames, or 0 for
+// a non-animated image.
+func (i *Image) FrameCount() int {
+	return len(i.frames)
+}
+
+// SetFrame displays frame n of the decoded animation immediately,
+// without affecting playback.
+func (i *Image) SetFrame(n int) *Image {
+	if n < 0 || n >= len(i.frames) {
+		return i
+	}
+	i.frameIndex = n
+	i.SetImage(i.frames[n])
+	if i.onFrame != nil {
+		i.onFrame(n)
+	}
+	return i
+}
+
+// SetLoop controls whether Play restarts from frame 0 after the last
+// frame, or stops there.
+func (i *Image) SetLoop(loop bool) *Image {
+	i.loop = loop
+	return i
+}
+
+// OnFrame registers fn to be called with the new frame index every
+// time Play advances a frame.
+func (i *Image) OnFrame(fn func(i int)) *Image {
+	i.onFrame = fn
+	return i
+}
+
+// Play starts advancing through the decoded animation frames on the
+// delays stored in the source GIF, using Tk's "after" scheduler so
+// each tick runs on the main interpreter goroutine. It is a no-op if
+// the image has fewer than two frames or is already playing.
+func (i *Image) Play() *Image {
+	if i.FrameCount() < 2 || i.playing {
+		return i
+	}
+	i.playing = true
+	if i.animFnId == "" {
+		i.animFnId = makeAnimId()
+		mainInterp.CreateAction(i.animFnId, func(args []string) {
+			i.tick()
+		})
+	}
+	i.scheduleNextFrame()
+	return i
+}
+
+// Pause stops Play from advancing further frames. The image remains
+// on whichever frame was showing.
+func (i *Image) Pause() *Image {
+	i.playing = false
+	return i
+}
+
+// tick is invoked by the "after" callback registered once in Play; it
+// advances to the next frame and, unless playback stopped or ran out
+// of loops, reschedules itself on the same Tcl command.
+func (i *Image) tick() {
+	if !i.playing {
+		return
+	}
+	next := i.frameIndex + 1
+	if next >= i.FrameCount() {
+		if !i.loop {
+			i.playing = false
+			return
+		}
+		next = 0
+	}
+	i.SetFrame(next)
+	i.scheduleNextFrame()
+}
+
+func (i *Image) scheduleNextFrame() {
+	if !i.playing {
+		return
+	}
+	delay := 100
+	if i.frameIndex < len(i.delays) {
+		delay = i.delays[i.frameIndex]
+	}
+	err := eval(fmt.Sprintf("after %v %v", delay, i.animFnId))
+	if err != nil {
+		dumpError(err)
+	}
+}
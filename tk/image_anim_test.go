@@ -0,0 +1,108 @@
+// Copyright 2018 visualfc. All rights reserved.
+
+package tk
+
+import (
+	"image"
+	"image/color"
+	"image/gif"
+	"os"
+	"testing"
+)
+
+func writeTestGif(t *testing.T, delays []int) string {
+	t.Helper()
+	pal := color.Palette{color.White, color.Black}
+	g := &gif.GIF{Delay: delays}
+	for range delays {
+		g.Image = append(g.Image, image.NewPaletted(image.Rect(0, 0, 2, 2), pal))
+	}
+	f, err := os.CreateTemp(t.TempDir(), "anim-*.gif")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if err := gif.EncodeAll(f, g); err != nil {
+		t.Fatal(err)
+	}
+	return f.Name()
+}
+
+func TestDecodeGifFrames(t *testing.T) {
+	// GIF delays are in 1/100s; a delay of 10 is 100ms and a delay of
+	// 0 (common in throbbers that rely on the app's own timer) should
+	// be clamped to a sane default rather than spinning at 0ms.
+	file := writeTestGif(t, []int{10, 0, 25})
+
+	frames, delays, err := decodeGifFrames(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(frames) != 3 {
+		t.Fatalf("len(frames) = %v, want 3", len(frames))
+	}
+	want := []int{100, 100, 250}
+	for i, d := range delays {
+		if d != want[i] {
+			t.Fatalf("delays[%v] = %v, want %v", i, d, want[i])
+		}
+	}
+}
+
+func TestDecodeGifFramesSingleFrame(t *testing.T) {
+	file := writeTestGif(t, []int{10})
+
+	frames, _, err := decodeGifFrames(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(frames) != 1 {
+		t.Fatalf("len(frames) = %v, want 1", len(frames))
+	}
+}
+
+// TestDecodeGifFramesCompositesPartialFrames covers the common GIF
+// optimization where a later frame's block only encodes the
+// sub-rectangle that actually changed. image/gif.DecodeAll hands that
+// sub-rectangle back uncomposited, so decodeGifFrames must paint it
+// onto the previous frame's canvas rather than showing it on its own.
+func TestDecodeGifFramesCompositesPartialFrames(t *testing.T) {
+	pal := color.Palette{color.Black, color.White}
+	full := image.NewPaletted(image.Rect(0, 0, 4, 4), pal)
+	for i := range full.Pix {
+		full.Pix[i] = 0 // black
+	}
+	partial := image.NewPaletted(image.Rect(1, 1, 3, 3), pal)
+	for i := range partial.Pix {
+		partial.Pix[i] = 1 // white
+	}
+	g := &gif.GIF{
+		Image:    []*image.Paletted{full, partial},
+		Delay:    []int{10, 10},
+		Disposal: []byte{gif.DisposalNone, gif.DisposalNone},
+	}
+	f, err := os.CreateTemp(t.TempDir(), "anim-*.gif")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if err := gif.EncodeAll(f, g); err != nil {
+		t.Fatal(err)
+	}
+
+	frames, _, err := decodeGifFrames(f.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(frames) != 2 {
+		t.Fatalf("len(frames) = %v, want 2", len(frames))
+	}
+
+	second := frames[1]
+	if r, gr, b, _ := second.At(0, 0).RGBA(); r != 0 || gr != 0 || b != 0 {
+		t.Fatalf("pixel outside the partial frame's rect = %v,%v,%v, want black carried over from frame 0", r, gr, b)
+	}
+	if r, gr, b, _ := second.At(1, 1).RGBA(); r == 0 && gr == 0 && b == 0 {
+		t.Fatal("pixel inside the partial frame's rect is still black, frame 1 was never composited")
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2018 visualfc. All rights reserved.
+
+package tk
+
+import (
+	"fmt"
+	"image"
+	"math"
+)
+
+// LoadImageScaled loads file as a variant of a logical image tagged
+// with the given pixel-density scale (e.g. 1 for a base asset, 2 for
+// an @2x asset). Use (*Image).AddVariant to register further
+// densities on the same Image; the highest-resolution variant whose
+// scale best matches "tk scaling" is selected automatically.
+func LoadImageScaled(file string, scale float64, options ...*ImageOpt) (*Image, error) {
+	im, err := LoadImage(file, options...)
+	if err != nil {
+		return nil, err
+	}
+	if fi := im.ToImage(); fi != nil {
+		im.AddVariant(fi, scale)
+	}
+	return im, nil
+}
+
+// AddVariant registers img as the pixel-density variant to use when
+// "tk scaling" is closest to scale, and immediately re-selects the
+// best variant for the current scaling factor.
+func (i *Image) AddVariant(img image.Image, scale float64) *Image {
+	if i.variants == nil {
+		i.variants = make(map[float64]image.Image)
+	}
+	i.variants[scale] = img
+	i.selectVariant()
+	return i
+}
+
+func (i *Image) selectVariant() {
+	if len(i.variants) == 0 {
+		return
+	}
+	target, err := evalAsFloat64("tk scaling")
+	if err != nil {
+		target = 1
+	}
+	i.SetImage(i.variants[closestVariantScale(i.variants, target)])
+}
+
+// closestVariantScale returns the key in variants whose scale is
+// nearest to target, e.g. picking the @2x variant when "tk scaling"
+// reports 2. Pulled out of selectVariant so the selection logic can be
+// tested without a live Tk interpreter.
+func closestVariantScale(variants map[float64]image.Image, target float64) float64 {
+	var best float64
+	first := true
+	for scale := range variants {
+		d, bd := math.Abs(scale-target), math.Abs(best-target)
+		if first || d < bd || (d == bd && scale > best) {
+			best = scale
+			first = false
+		}
+	}
+	return best
+}
+
+// CopyRegion copies srcRect from src into i at dst, wrapping the Tk
+// photo "copy -from ... -to ..." command.
+func (i *Image) CopyRegion(src *Image, srcRect image.Rectangle, dst image.Point) error {
+	if src == nil || !src.IsValid() {
+		return ErrInvalid
+	}
+	script := fmt.Sprintf("%v copy %v -from %v %v %v %v -to %v %v", i.id, src.id,
+		srcRect.Min.X, srcRect.Min.Y, srcRect.Max.X, srcRect.Max.Y, dst.X, dst.Y)
+	return eval(script)
+}
+
+// Subsample returns a new Image holding every xth pixel in the X
+// direction and every yth pixel in the Y direction of i, wrapping the
+// Tk photo "copy -subsample" command.
+func (i *Image) Subsample(x, y int) *Image {
+	dst := NewImage()
+	if dst == nil {
+		return nil
+	}
+	err := eval(fmt.Sprintf("%v copy %v -subsample %v %v", dst.id, i.id, x, y))
+	if err != nil {
+		dumpError(err)
+	}
+	return dst
+}
+
+// Zoom returns a new Image with i magnified x times in the X
+// direction and y times in the Y direction, wrapping the Tk photo
+// "copy -zoom" command.
+func (i *Image) Zoom(x, y int) *Image {
+	dst := NewImage()
+	if dst == nil {
+		return nil
+	}
+	err := eval(fmt.Sprintf("%v copy %v -zoom %v %v", dst.id, i.id, x, y))
+	if err != nil {
+		dumpError(err)
+	}
+	return dst
+}
@@ -0,0 +1,31 @@
+// Copyright 2018 visualfc. All rights reserved.
+
+package tk
+
+import (
+	"image"
+	"testing"
+)
+
+func TestClosestVariantScale(t *testing.T) {
+	variants := map[float64]image.Image{
+		1: nil,
+		2: nil,
+		3: nil,
+	}
+	cases := []struct {
+		target float64
+		want   float64
+	}{
+		{1, 1},
+		{2, 2},
+		{1.9, 2},
+		{2.6, 3},
+		{10, 3},
+	}
+	for _, c := range cases {
+		if v := closestVariantScale(variants, c.target); v != c.want {
+			t.Fatalf("closestVariantScale(target=%v) = %v, want %v", c.target, v, c.want)
+		}
+	}
+}